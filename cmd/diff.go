@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"bxfferoverflow.me/code-stats/internal/colorflag"
+	"bxfferoverflow.me/code-stats/internal/report"
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+)
+
+// diffCmd compares two JSON baselines exported via `scan --format json`
+// (e.g. with `-o baseline.json`) and reports per-extension deltas, so a CI
+// pipeline can gate on things like "comment ratio dropped" or "LOC grew
+// by >N%" without re-scanning the whole tree.
+var diffCmd = &cobra.Command{
+	Use:   "diff <before.json> <after.json>",
+	Short: "Show per-extension deltas between two exported stats baselines",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runDiff,
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	if !colorflag.Valid(colorModeFlag) {
+		return colorflag.ErrInvalidMode(colorModeFlag)
+	}
+
+	before, err := loadBaseline(args[0])
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", args[0], err)
+	}
+	after, err := loadBaseline(args[1])
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", args[1], err)
+	}
+
+	delta := diffData(before, after)
+	format := resolveFormat(cmd)
+
+	out := os.Stdout
+	if outputFile != "" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			return nil
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if format == "table" {
+		useColor := colorflag.Enabled(colorModeFlag, out)
+		printDiffTable(out, delta, useColor)
+		return nil
+	}
+
+	formatter, err := report.Get(format)
+	if err != nil {
+		return err
+	}
+	if err := formatter.Format(out, delta, report.Options{}); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing %s output: %v\n", format, err)
+		return nil
+	}
+
+	if outputFile != "" {
+		fmt.Printf("Exported diff as %s to %s\n", format, outputFile)
+	}
+	return nil
+}
+
+func loadBaseline(path string) (report.Data, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return report.Data{}, err
+	}
+	var data report.Data
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return report.Data{}, err
+	}
+	return data, nil
+}
+
+// diffData computes after-before per extension, treating an extension
+// missing from one side as all-zero so additions/removals show up as a
+// pure positive or negative delta.
+func diffData(before, after report.Data) report.Data {
+	byExt := make(map[string]report.Stats)
+	for _, s := range before.Stats {
+		byExt[s.Extension] = report.Stats{}
+	}
+	beforeByExt := make(map[string]report.Stats)
+	for _, s := range before.Stats {
+		beforeByExt[s.Extension] = s
+	}
+	afterByExt := make(map[string]report.Stats)
+	for _, s := range after.Stats {
+		afterByExt[s.Extension] = s
+		byExt[s.Extension] = report.Stats{}
+	}
+
+	var stats []report.Stats
+	for ext := range byExt {
+		b := beforeByExt[ext]
+		a := afterByExt[ext]
+		stats = append(stats, report.Stats{
+			Extension:       ext,
+			FileCount:       a.FileCount - b.FileCount,
+			TotalLines:      a.TotalLines - b.TotalLines,
+			CommentLines:    a.CommentLines - b.CommentLines,
+			MixedLines:      a.MixedLines - b.MixedLines,
+			EmptyLines:      a.EmptyLines - b.EmptyLines,
+			AvgLinesPerFile: a.AvgLinesPerFile - b.AvgLinesPerFile,
+		})
+	}
+
+	return report.Data{
+		Stats: stats,
+		Summary: report.Summary{
+			TotalFiles:        after.Summary.TotalFiles - before.Summary.TotalFiles,
+			TotalLines:        after.Summary.TotalLines - before.Summary.TotalLines,
+			TotalCommentLines: after.Summary.TotalCommentLines - before.Summary.TotalCommentLines,
+			TotalMixedLines:   after.Summary.TotalMixedLines - before.Summary.TotalMixedLines,
+			TotalEmptyLines:   after.Summary.TotalEmptyLines - before.Summary.TotalEmptyLines,
+			AvgLinesPerFile:   after.Summary.AvgLinesPerFile - before.Summary.AvgLinesPerFile,
+		},
+	}
+}
+
+// printDiffTable renders a delta as a table with explicit +/- signs,
+// green for growth and red for shrinkage. It's kept separate from
+// report.Formatter because that interface renders absolute values, not
+// deltas, and diff is the only command that needs this coloring.
+func printDiffTable(w *os.File, delta report.Data, useColor bool) {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	t.AppendHeader(table.Row{"Extension", "Files", "Lines", "Comment Lines", "Mixed Lines", "Empty Lines", "Avg Lines/File"})
+	for _, s := range delta.Stats {
+		t.AppendRow(table.Row{
+			s.Extension,
+			signedInt(s.FileCount, useColor),
+			signedInt(s.TotalLines, useColor),
+			signedInt(s.CommentLines, useColor),
+			signedInt(s.MixedLines, useColor),
+			signedInt(s.EmptyLines, useColor),
+			signedFloat(s.AvgLinesPerFile, useColor),
+		})
+	}
+	t.Render()
+
+	sumT := table.NewWriter()
+	sumT.SetOutputMirror(w)
+	sumT.AppendHeader(table.Row{"Total Files", "Total Lines", "Total Comment Lines", "Total Mixed Lines", "Total Empty Lines", "Avg Lines/File"})
+	sumT.AppendRow(table.Row{
+		signedInt(delta.Summary.TotalFiles, useColor),
+		signedInt(delta.Summary.TotalLines, useColor),
+		signedInt(delta.Summary.TotalCommentLines, useColor),
+		signedInt(delta.Summary.TotalMixedLines, useColor),
+		signedInt(delta.Summary.TotalEmptyLines, useColor),
+		signedFloat(delta.Summary.AvgLinesPerFile, useColor),
+	})
+	sumT.Render()
+}
+
+func signedInt(v int64, useColor bool) string {
+	s := fmt.Sprintf("%+d", v)
+	return colorizeDelta(s, v, useColor)
+}
+
+func signedFloat(v float64, useColor bool) string {
+	s := fmt.Sprintf("%+.2f", v)
+	return colorizeDelta(s, int64(v*100), useColor)
+}
+
+func colorizeDelta(s string, v int64, useColor bool) string {
+	if !useColor || v == 0 {
+		return s
+	}
+	if v > 0 {
+		return color.New(color.FgGreen).Sprint(s)
+	}
+	return color.New(color.FgRed).Sprint(s)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"bxfferoverflow.me/code-stats/internal/report"
+)
+
+func TestRunDiffInvalidFormatReturnsError(t *testing.T) {
+	origFormat, origColor := formatFlag, colorModeFlag
+	formatFlag, colorModeFlag = "bogus", "auto"
+	defer func() { formatFlag, colorModeFlag = origFormat, origColor }()
+
+	dir := t.TempDir()
+	before := filepath.Join(dir, "before.json")
+	after := filepath.Join(dir, "after.json")
+	if err := os.WriteFile(before, []byte(`{"stats":[],"summary":{}}`), 0o644); err != nil {
+		t.Fatalf("write before: %v", err)
+	}
+	if err := os.WriteFile(after, []byte(`{"stats":[],"summary":{}}`), 0o644); err != nil {
+		t.Fatalf("write after: %v", err)
+	}
+
+	if err := runDiff(diffCmd, []string{before, after}); err == nil {
+		t.Fatal("expected an error for an unknown --format value, got nil")
+	}
+}
+
+func TestDiffDataComputesAfterMinusBefore(t *testing.T) {
+	before := report.Data{
+		Stats:   []report.Stats{{Extension: ".go", FileCount: 2, TotalLines: 100}},
+		Summary: report.Summary{TotalFiles: 2, TotalLines: 100},
+	}
+	after := report.Data{
+		Stats:   []report.Stats{{Extension: ".go", FileCount: 3, TotalLines: 150}},
+		Summary: report.Summary{TotalFiles: 3, TotalLines: 150},
+	}
+
+	delta := diffData(before, after)
+
+	if len(delta.Stats) != 1 {
+		t.Fatalf("got %d stats rows, want 1: %+v", len(delta.Stats), delta.Stats)
+	}
+	got := delta.Stats[0]
+	if got.FileCount != 1 || got.TotalLines != 50 {
+		t.Errorf("got %+v, want FileCount=1 TotalLines=50", got)
+	}
+	if delta.Summary.TotalFiles != 1 || delta.Summary.TotalLines != 50 {
+		t.Errorf("got summary %+v, want TotalFiles=1 TotalLines=50", delta.Summary)
+	}
+}
+
+func TestDiffDataTreatsMissingExtensionAsZero(t *testing.T) {
+	before := report.Data{Stats: []report.Stats{{Extension: ".go", FileCount: 2, TotalLines: 100}}}
+	after := report.Data{Stats: []report.Stats{
+		{Extension: ".go", FileCount: 2, TotalLines: 100},
+		{Extension: ".py", FileCount: 1, TotalLines: 40},
+	}}
+
+	delta := diffData(before, after)
+
+	var py report.Stats
+	found := false
+	for _, s := range delta.Stats {
+		if s.Extension == ".py" {
+			py, found = s, true
+		}
+	}
+	if !found {
+		t.Fatalf(".py extension missing from delta: %+v", delta.Stats)
+	}
+	if py.FileCount != 1 || py.TotalLines != 40 {
+		t.Errorf("a new extension should diff against all-zero, got %+v", py)
+	}
+}
+
+func TestDiffDataRemovedExtensionIsNegative(t *testing.T) {
+	before := report.Data{Stats: []report.Stats{{Extension: ".go", FileCount: 2, TotalLines: 100}}}
+	after := report.Data{}
+
+	delta := diffData(before, after)
+
+	if len(delta.Stats) != 1 {
+		t.Fatalf("got %d stats rows, want 1: %+v", len(delta.Stats), delta.Stats)
+	}
+	got := delta.Stats[0]
+	if got.FileCount != -2 || got.TotalLines != -100 {
+		t.Errorf("a removed extension should diff to a negative delta, got %+v", got)
+	}
+}
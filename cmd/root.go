@@ -0,0 +1,120 @@
+// Package cmd wires code-stats' cobra commands: scan (the historical
+// default), diff, and watch.
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"bxfferoverflow.me/code-stats/internal/report"
+	"bxfferoverflow.me/code-stats/internal/scan"
+	"github.com/spf13/cobra"
+)
+
+var (
+	extFlag        string
+	ignoreFlag     string
+	colorModeFlag  string
+	progressFlag   bool
+	formatFlag     string
+	jsonFlag       bool
+	csvFlag        bool
+	htmlFlag       bool
+	outputFile     string
+	verboseFlag    bool
+	docsOutDir     string
+	docsFormatFlag string
+	jobsFlag       int
+)
+
+// rootCmd runs the same runScan as scanCmd, so `code-stats [dir]` keeps
+// working exactly as it did before subcommands existed.
+var rootCmd = &cobra.Command{
+	Use:   "code-stats [directory]",
+	Short: "Count files, lines, comments, and more in a codebase.",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runScan,
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&extFlag, "ext", "e", "", "Comma-separated list of file extensions to include (e.g. 'go,js,ts')")
+	rootCmd.PersistentFlags().StringVarP(&ignoreFlag, "ignore", "i", "", "Comma-separated list of directories to ignore (e.g. 'node_modules,dist,.git')")
+	rootCmd.PersistentFlags().StringVarP(&colorModeFlag, "color", "c", "auto", "Color mode: auto|always|never")
+	rootCmd.PersistentFlags().StringVarP(&formatFlag, "format", "f", "table", "Output format: "+strings.Join(report.Names, "|"))
+	rootCmd.PersistentFlags().StringVarP(&outputFile, "output", "o", "", "Output file (stdout if empty)")
+
+	rootCmd.PersistentFlags().BoolVarP(&progressFlag, "progress", "p", false, "Show progress output for each processed file")
+	rootCmd.PersistentFlags().BoolVar(&jsonFlag, "json", false, "Deprecated: use --format json")
+	rootCmd.PersistentFlags().BoolVar(&csvFlag, "csv", false, "Deprecated: use --format csv")
+	rootCmd.PersistentFlags().BoolVar(&htmlFlag, "html", false, "Deprecated: use --format html")
+	rootCmd.PersistentFlags().BoolVar(&verboseFlag, "verbose", false, "Disable all console output except errors")
+	rootCmd.PersistentFlags().StringVar(&docsOutDir, "docs", "", "Generate a browsable API doc site in this directory alongside the stats")
+	rootCmd.PersistentFlags().StringVar(&docsFormatFlag, "docs-format", "html", "Docs output format: html|json")
+	rootCmd.PersistentFlags().IntVarP(&jobsFlag, "jobs", "j", 0, "Max files analyzed concurrently (default: runtime.NumCPU())")
+
+	rootCmd.AddCommand(scanCmd, diffCmd, watchCmd)
+}
+
+// Execute runs the root command, the single entry point main() calls.
+func Execute() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+}
+
+// resolveExtensions parses the comma-separated --ext flag, falling back
+// to scan.DefaultExtensions when it's empty.
+func resolveExtensions() []string {
+	if extFlag == "" {
+		return scan.DefaultExtensions
+	}
+	parts := strings.Split(extFlag, ",")
+	extensions := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.HasPrefix(p, ".") {
+			p = "." + p
+		}
+		extensions = append(extensions, p)
+	}
+	return extensions
+}
+
+// resolveIgnoreDirs parses the comma-separated --ignore flag, falling
+// back to scan.DefaultIgnoreDirs when it's empty.
+func resolveIgnoreDirs() []string {
+	if ignoreFlag == "" {
+		return scan.DefaultIgnoreDirs
+	}
+	parts := strings.Split(ignoreFlag, ",")
+	ignoreList := make([]string, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			ignoreList = append(ignoreList, p)
+		}
+	}
+	return ignoreList
+}
+
+// resolveFormat applies the deprecated --json/--csv/--html aliases when
+// the caller hasn't passed --format explicitly.
+func resolveFormat(cmd *cobra.Command) string {
+	format := formatFlag
+	if !cmd.Flags().Changed("format") {
+		switch {
+		case jsonFlag:
+			format = "json"
+		case csvFlag:
+			format = "csv"
+		case htmlFlag:
+			format = "html"
+		}
+	}
+	return format
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"bxfferoverflow.me/code-stats/docs"
+	"bxfferoverflow.me/code-stats/internal/colorflag"
+	"bxfferoverflow.me/code-stats/internal/report"
+	"bxfferoverflow.me/code-stats/internal/scan"
+	"github.com/spf13/cobra"
+)
+
+// scanCmd is the historical default behavior: walk a directory once and
+// print a report. It's also what the bare `code-stats [dir]` invocation
+// runs, via rootCmd.RunE.
+var scanCmd = &cobra.Command{
+	Use:   "scan [directory]",
+	Short: "Scan a directory and report file/line/comment counts",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runScan,
+}
+
+func runScan(cmd *cobra.Command, args []string) error {
+	if !colorflag.Valid(colorModeFlag) {
+		return colorflag.ErrInvalidMode(colorModeFlag)
+	}
+	dir := "."
+	if len(args) > 0 {
+		dir = args[0]
+	}
+
+	var docCollector *docs.Collector
+	if docsOutDir != "" {
+		docCollector = docs.NewCollector()
+	}
+
+	idx := scan.NewIndex()
+	idx.Walk(dir, scan.Options{
+		Extensions:   resolveExtensions(),
+		IgnoreDirs:   resolveIgnoreDirs(),
+		ShowProgress: progressFlag && !verboseFlag,
+		DocCollector: docCollector,
+		Jobs:         jobsFlag,
+	})
+
+	format := resolveFormat(cmd)
+	formatter, err := report.Get(format)
+	if err != nil {
+		return err
+	}
+	data := idx.Data()
+
+	if docCollector != nil {
+		byFile := docCollector.Files()
+		var err error
+		if docsFormatFlag == "json" {
+			err = docs.GenerateJSON(docsOutDir, byFile, data.Summary)
+		} else {
+			err = docs.GenerateSite(docsOutDir, byFile, data.Summary)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error generating docs: %v\n", err)
+		} else if !verboseFlag {
+			fmt.Printf("Generated docs in %s\n", docsOutDir)
+		}
+	}
+
+	if verboseFlag && outputFile == "" {
+		return nil
+	}
+
+	out := os.Stdout
+	if outputFile != "" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error creating output file: %v\n", err)
+			return nil
+		}
+		defer f.Close()
+		out = f
+	}
+	useColor := colorflag.Enabled(colorModeFlag, out)
+
+	if err := formatter.Format(out, data, report.Options{UseColor: useColor}); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing %s output: %v\n", format, err)
+		return nil
+	}
+
+	if outputFile != "" && !verboseFlag {
+		fmt.Printf("Exported stats as %s to %s\n", format, outputFile)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"bxfferoverflow.me/code-stats/internal/scan"
+)
+
+// synthesizeTree writes n small Go files into a fresh temp directory and
+// returns its path, for benchmarking the scan pipeline end to end without
+// depending on a real checkout.
+func synthesizeTree(b *testing.B, n int) string {
+	b.Helper()
+	dir := b.TempDir()
+	src := "package pkg\n\n// comment\nfunc F() { g() }\n"
+	for i := 0; i < n; i++ {
+		sub := filepath.Join(dir, fmt.Sprintf("pkg%d", i%20))
+		if err := os.MkdirAll(sub, 0o755); err != nil {
+			b.Fatalf("mkdir: %v", err)
+		}
+		path := filepath.Join(sub, fmt.Sprintf("file%d.go", i))
+		if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+			b.Fatalf("write: %v", err)
+		}
+	}
+	return dir
+}
+
+// BenchmarkScan scans a synthesized tree of N files, making regressions
+// in the bounded worker pool visible.
+func BenchmarkScan(b *testing.B) {
+	dir := synthesizeTree(b, 2000)
+	opts := scan.Options{Extensions: []string{".go"}}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scan.NewIndex().Walk(dir, opts)
+	}
+}
@@ -0,0 +1,13 @@
+package cmd
+
+import "testing"
+
+func TestRunScanInvalidFormatReturnsError(t *testing.T) {
+	origFormat, origColor := formatFlag, colorModeFlag
+	formatFlag, colorModeFlag = "bogus", "auto"
+	defer func() { formatFlag, colorModeFlag = origFormat, origColor }()
+
+	if err := runScan(scanCmd, []string{t.TempDir()}); err == nil {
+		t.Fatal("expected an error for an unknown --format value, got nil")
+	}
+}
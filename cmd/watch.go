@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"bxfferoverflow.me/code-stats/internal/colorflag"
+	"bxfferoverflow.me/code-stats/internal/report"
+	"bxfferoverflow.me/code-stats/internal/scan"
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+)
+
+// watchCmd keeps a scan.Index in sync with a directory tree and
+// re-renders the report every time a tracked file changes, instead of
+// re-walking the whole tree on every edit.
+var watchCmd = &cobra.Command{
+	Use:   "watch [directory]",
+	Short: "Watch a directory and re-report on every change",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runWatch,
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	if !colorflag.Valid(colorModeFlag) {
+		return colorflag.ErrInvalidMode(colorModeFlag)
+	}
+	dir := "."
+	if len(args) > 0 {
+		dir = args[0]
+	}
+	extensions := resolveExtensions()
+	ignoreList := resolveIgnoreDirs()
+	format := resolveFormat(cmd)
+	formatter, err := report.Get(format)
+	if err != nil {
+		return err
+	}
+
+	idx := scan.NewIndex()
+	idx.Walk(dir, scan.Options{Extensions: extensions, IgnoreDirs: ignoreList, Jobs: jobsFlag})
+	render := func() {
+		useColor := colorflag.Enabled(colorModeFlag, os.Stdout)
+		if err := formatter.Format(os.Stdout, idx.Data(), report.Options{UseColor: useColor}); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s output: %v\n", format, err)
+		}
+	}
+	render()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("starting watcher: %w", err)
+	}
+	defer watcher.Close()
+
+	if err := addWatchDirs(watcher, dir, ignoreList); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Watching %s for changes (ctrl-c to stop)...\n", dir)
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if event.Op&(fsnotify.Create|fsnotify.Write|fsnotify.Remove|fsnotify.Rename) == 0 {
+				continue
+			}
+			info, statErr := os.Stat(event.Name)
+			if event.Op&fsnotify.Create != 0 && statErr == nil && info.IsDir() {
+				_ = addWatchDirs(watcher, event.Name, ignoreList)
+				continue
+			}
+
+			ext := filepath.Ext(event.Name)
+			if !slices.Contains(extensions, ext) {
+				continue
+			}
+
+			switch {
+			case event.Op&(fsnotify.Remove|fsnotify.Rename) != 0:
+				idx.RemoveFile(event.Name)
+			default:
+				if err := idx.UpsertFile(event.Name, ext); err != nil {
+					fmt.Fprintln(os.Stderr, "Error reading file:", event.Name, err)
+					continue
+				}
+			}
+			render()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			fmt.Fprintln(os.Stderr, "Watcher error:", err)
+		}
+	}
+}
+
+// addWatchDirs registers dir and every non-ignored subdirectory with
+// watcher; fsnotify only watches the directories it's told about, not
+// their descendants, so new subtrees are added as watch.go sees them
+// created.
+func addWatchDirs(watcher *fsnotify.Watcher, dir string, ignoreList []string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading directory %s: %w", dir, err)
+	}
+	if err := watcher.Add(dir); err != nil {
+		return fmt.Errorf("watching %s: %w", dir, err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if slices.Contains(ignoreList, entry.Name()) {
+			continue
+		}
+		if err := addWatchDirs(watcher, filepath.Join(dir, entry.Name()), ignoreList); err != nil {
+			return err
+		}
+	}
+	return nil
+}
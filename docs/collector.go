@@ -0,0 +1,37 @@
+package docs
+
+import "sync"
+
+// Collector accumulates Records per file as a scan's goroutines extract
+// them, guarded by a mutex the same way scan.Index guards the stats.
+type Collector struct {
+	mu     sync.Mutex
+	byFile map[string][]Record
+}
+
+// NewCollector returns an empty Collector.
+func NewCollector() *Collector {
+	return &Collector{byFile: make(map[string][]Record)}
+}
+
+// Add appends records under file. A nil or empty slice is a no-op so
+// files with no recognized extractor never produce an empty entry.
+func (c *Collector) Add(file string, records []Record) {
+	if len(records) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byFile[file] = append(c.byFile[file], records...)
+}
+
+// Files returns a copy of the accumulated per-file records.
+func (c *Collector) Files() map[string][]Record {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make(map[string][]Record, len(c.byFile))
+	for k, v := range c.byFile {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,57 @@
+// Package docs extracts documentation blocks attached to declarations
+// (functions, types, consts, vars) from source files and renders them as
+// a browsable site, turning code-stats into a minimal project-wide
+// documentation dashboard.
+package docs
+
+// Kind is the declaration kind a Record documents.
+type Kind string
+
+const (
+	KindFunc  Kind = "func"
+	KindType  Kind = "type"
+	KindConst Kind = "const"
+	KindVar   Kind = "var"
+)
+
+// Record is one documented declaration.
+type Record struct {
+	Kind      Kind   `json:"kind"`
+	Name      string `json:"name"`
+	Signature string `json:"signature"`
+	Doc       string `json:"doc"`
+	File      string `json:"file"`
+	Line      int    `json:"line"`
+}
+
+// Extractor pulls documentation Records out of a single source file.
+type Extractor interface {
+	Extract(path string) ([]Record, error)
+}
+
+// Extractors maps file extensions (including the leading dot) to the
+// Extractor that understands that language's doc-comment convention.
+var Extractors = map[string]Extractor{
+	".go":  goExtractor{},
+	".js":  jsExtractor{},
+	".jsx": jsExtractor{},
+	".ts":  jsExtractor{},
+	".tsx": jsExtractor{},
+	".py":  pythonExtractor{},
+}
+
+// Supports reports whether ext has a registered Extractor.
+func Supports(ext string) bool {
+	_, ok := Extractors[ext]
+	return ok
+}
+
+// Extract runs the Extractor registered for ext against path. It returns
+// (nil, nil) for extensions with no registered Extractor.
+func Extract(path, ext string) ([]Record, error) {
+	e, ok := Extractors[ext]
+	if !ok {
+		return nil, nil
+	}
+	return e.Extract(path)
+}
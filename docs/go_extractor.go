@@ -0,0 +1,81 @@
+package docs
+
+import (
+	"bytes"
+	"go/ast"
+	"go/doc"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// goExtractor documents Go declarations using the standard library's own
+// go/parser and go/doc, so it follows the same rules gofmt and `go doc`
+// already apply for what counts as a declaration's doc comment.
+type goExtractor struct{}
+
+func (goExtractor) Extract(path string) ([]Record, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := doc.NewFromFiles(fset, []*ast.File{f}, "command-line-arguments", doc.AllDecls)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	sig := func(node ast.Node) string {
+		var buf bytes.Buffer
+		if err := format.Node(&buf, fset, node); err != nil {
+			return ""
+		}
+		first, _, _ := strings.Cut(buf.String(), "\n")
+		return first
+	}
+	lineOf := func(pos token.Pos) int { return fset.Position(pos).Line }
+
+	for _, fn := range pkg.Funcs {
+		records = append(records, Record{
+			Kind: KindFunc, Name: fn.Name, Signature: sig(fn.Decl),
+			Doc: strings.TrimSpace(fn.Doc), File: path, Line: lineOf(fn.Decl.Pos()),
+		})
+	}
+	for _, t := range pkg.Types {
+		records = append(records, Record{
+			Kind: KindType, Name: t.Name, Signature: sig(t.Decl),
+			Doc: strings.TrimSpace(t.Doc), File: path, Line: lineOf(t.Decl.Pos()),
+		})
+		for _, fn := range t.Methods {
+			records = append(records, Record{
+				Kind: KindFunc, Name: t.Name + "." + fn.Name, Signature: sig(fn.Decl),
+				Doc: strings.TrimSpace(fn.Doc), File: path, Line: lineOf(fn.Decl.Pos()),
+			})
+		}
+		// go/doc groups constructor-shaped functions like NewXxx() *T under
+		// the type they return rather than pkg.Funcs, so they'd otherwise
+		// never make it into records.
+		for _, fn := range t.Funcs {
+			records = append(records, Record{
+				Kind: KindFunc, Name: fn.Name, Signature: sig(fn.Decl),
+				Doc: strings.TrimSpace(fn.Doc), File: path, Line: lineOf(fn.Decl.Pos()),
+			})
+		}
+	}
+	for _, c := range pkg.Consts {
+		records = append(records, Record{
+			Kind: KindConst, Name: strings.Join(c.Names, ", "), Signature: sig(c.Decl),
+			Doc: strings.TrimSpace(c.Doc), File: path, Line: lineOf(c.Decl.Pos()),
+		})
+	}
+	for _, v := range pkg.Vars {
+		records = append(records, Record{
+			Kind: KindVar, Name: strings.Join(v.Names, ", "), Signature: sig(v.Decl),
+			Doc: strings.TrimSpace(v.Doc), File: path, Line: lineOf(v.Decl.Pos()),
+		})
+	}
+	return records, nil
+}
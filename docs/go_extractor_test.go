@@ -0,0 +1,69 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func extractGo(t *testing.T, src string) []Record {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fixture.go")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+	records, err := goExtractor{}.Extract(path)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	return records
+}
+
+func findRecord(records []Record, name string) (Record, bool) {
+	for _, r := range records {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return Record{}, false
+}
+
+func TestGoExtractorConstructorFunc(t *testing.T) {
+	src := `package pkg
+
+// Index tracks per-extension line counts.
+type Index struct{}
+
+// NewIndex returns an empty Index.
+func NewIndex() *Index {
+	return &Index{}
+}
+`
+	records := extractGo(t, src)
+	r, ok := findRecord(records, "NewIndex")
+	if !ok {
+		t.Fatalf("NewIndex missing from records: %+v", records)
+	}
+	if r.Kind != KindFunc {
+		t.Errorf("got Kind %q, want %q", r.Kind, KindFunc)
+	}
+	if r.Doc != "NewIndex returns an empty Index." {
+		t.Errorf("got Doc %q", r.Doc)
+	}
+}
+
+func TestGoExtractorIncludesUnexportedDeclarations(t *testing.T) {
+	src := `package pkg
+
+// classifyLine advances the state machine by one line.
+func classifyLine() {}
+`
+	records := extractGo(t, src)
+	r, ok := findRecord(records, "classifyLine")
+	if !ok {
+		t.Fatalf("classifyLine missing from records: %+v", records)
+	}
+	if r.Doc != "classifyLine advances the state machine by one line." {
+		t.Errorf("got Doc %q", r.Doc)
+	}
+}
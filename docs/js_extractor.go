@@ -0,0 +1,82 @@
+package docs
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+// jsDeclRe matches the declaration forms code-stats treats as
+// documentable: a function, a class, or a const, each optionally exported.
+var jsDeclRe = regexp.MustCompile(`^(?:export\s+)?(?:default\s+)?(?:async\s+)?(function\s+(\w+)|class\s+(\w+)|const\s+(\w+))`)
+
+// jsExtractor finds /** ... */ JSDoc blocks that sit immediately before a
+// function/class/export/const declaration. It tracks block-comment state
+// line by line rather than parsing a full JS/TS AST, which keeps it
+// dependency-free at the cost of missing declarations split across
+// multiple lines before the opening brace.
+type jsExtractor struct{}
+
+func (jsExtractor) Extract(path string) ([]Record, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(content), "\n")
+
+	var records []Record
+	var doc []string
+	inBlock := false
+
+	for i, raw := range lines {
+		line := strings.TrimSpace(raw)
+		switch {
+		case inBlock:
+			text := strings.TrimSuffix(line, "*/")
+			text = strings.TrimPrefix(strings.TrimSpace(text), "*")
+			doc = append(doc, strings.TrimSpace(text))
+			if strings.HasSuffix(line, "*/") {
+				inBlock = false
+			}
+		case strings.HasPrefix(line, "/**"):
+			doc = nil
+			inBlock = !strings.HasSuffix(line, "*/") || line == "/**"
+			rest := strings.TrimSuffix(strings.TrimPrefix(line, "/**"), "*/")
+			if t := strings.TrimSpace(rest); t != "" {
+				doc = append(doc, t)
+			}
+		case line == "":
+			doc = nil
+		default:
+			if m := jsDeclRe.FindStringSubmatch(line); m != nil && len(doc) > 0 {
+				name := firstNonEmpty(m[2], m[3], m[4])
+				kind := KindVar
+				switch {
+				case m[2] != "":
+					kind = KindFunc
+				case m[3] != "":
+					kind = KindType
+				}
+				records = append(records, Record{
+					Kind:      kind,
+					Name:      name,
+					Signature: line,
+					Doc:       strings.TrimSpace(strings.Join(doc, "\n")),
+					File:      path,
+					Line:      i + 1,
+				})
+			}
+			doc = nil
+		}
+	}
+	return records, nil
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,62 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func extractJS(t *testing.T, ext, src string) []Record {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fixture"+ext)
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+	records, err := jsExtractor{}.Extract(path)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	return records
+}
+
+func TestJSExtractorFunction(t *testing.T) {
+	src := "/**\n * Adds two numbers.\n */\nexport function add(a, b) {\n  return a + b;\n}\n"
+	records := extractJS(t, ".js", src)
+	r, ok := findRecord(records, "add")
+	if !ok {
+		t.Fatalf("add missing from records: %+v", records)
+	}
+	if r.Kind != KindFunc {
+		t.Errorf("got Kind %q, want %q", r.Kind, KindFunc)
+	}
+	if r.Doc != "Adds two numbers." {
+		t.Errorf("got Doc %q", r.Doc)
+	}
+}
+
+func TestJSExtractorClass(t *testing.T) {
+	src := "/** Represents a point. */\nclass Point {}\n"
+	records := extractJS(t, ".ts", src)
+	r, ok := findRecord(records, "Point")
+	if !ok {
+		t.Fatalf("Point missing from records: %+v", records)
+	}
+	if r.Kind != KindType {
+		t.Errorf("got Kind %q, want %q", r.Kind, KindType)
+	}
+}
+
+func TestJSExtractorIgnoresUndocumentedDeclarations(t *testing.T) {
+	records := extractJS(t, ".js", "function undocumented() {}\n")
+	if len(records) != 0 {
+		t.Errorf("expected no records, got %+v", records)
+	}
+}
+
+func TestJSExtractorBlankLineResetsPendingDoc(t *testing.T) {
+	src := "/**\n * Stale doc.\n */\n\nfunction f() {}\n"
+	records := extractJS(t, ".js", src)
+	if len(records) != 0 {
+		t.Errorf("expected the blank line to drop the pending doc, got %+v", records)
+	}
+}
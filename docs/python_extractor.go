@@ -0,0 +1,79 @@
+package docs
+
+import (
+	"os"
+	"regexp"
+	"strings"
+)
+
+var pyDeclRe = regexp.MustCompile(`^(def|class)\s+(\w+)`)
+
+// pythonExtractor documents `def`/`class` declarations using the
+// triple-quoted docstring on the line(s) immediately following them.
+type pythonExtractor struct{}
+
+func (pythonExtractor) Extract(path string) ([]Record, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(string(content), "\n")
+
+	var records []Record
+	for i, raw := range lines {
+		m := pyDeclRe.FindStringSubmatch(strings.TrimSpace(raw))
+		if m == nil {
+			continue
+		}
+		kind := KindFunc
+		if m[1] == "class" {
+			kind = KindType
+		}
+		doc := extractDocstring(lines, i+1)
+		records = append(records, Record{
+			Kind:      kind,
+			Name:      m[2],
+			Signature: strings.TrimSpace(raw),
+			Doc:       doc,
+			File:      path,
+			Line:      i + 1,
+		})
+	}
+	return records, nil
+}
+
+// extractDocstring reads the triple-quoted string starting at or after
+// line index `from`, skipping blank lines, and returns its trimmed body.
+func extractDocstring(lines []string, from int) string {
+	j := from
+	for j < len(lines) && strings.TrimSpace(lines[j]) == "" {
+		j++
+	}
+	if j >= len(lines) {
+		return ""
+	}
+
+	trimmed := strings.TrimSpace(lines[j])
+	for _, quote := range []string{`"""`, "'''"} {
+		if !strings.HasPrefix(trimmed, quote) {
+			continue
+		}
+		rest := strings.TrimPrefix(trimmed, quote)
+		if idx := strings.Index(rest, quote); idx >= 0 {
+			return strings.TrimSpace(rest[:idx])
+		}
+		var sb strings.Builder
+		sb.WriteString(rest)
+		for k := j + 1; k < len(lines); k++ {
+			if idx := strings.Index(lines[k], quote); idx >= 0 {
+				sb.WriteString("\n")
+				sb.WriteString(lines[k][:idx])
+				return strings.TrimSpace(sb.String())
+			}
+			sb.WriteString("\n")
+			sb.WriteString(lines[k])
+		}
+		return strings.TrimSpace(sb.String())
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package docs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func extractPython(t *testing.T, src string) []Record {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fixture.py")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+	records, err := pythonExtractor{}.Extract(path)
+	if err != nil {
+		t.Fatalf("Extract: %v", err)
+	}
+	return records
+}
+
+func TestPythonExtractorFunctionSingleLineDocstring(t *testing.T) {
+	src := "def add(a, b):\n    \"\"\"Adds two numbers.\"\"\"\n    return a + b\n"
+	records := extractPython(t, src)
+	r, ok := findRecord(records, "add")
+	if !ok {
+		t.Fatalf("add missing from records: %+v", records)
+	}
+	if r.Kind != KindFunc {
+		t.Errorf("got Kind %q, want %q", r.Kind, KindFunc)
+	}
+	if r.Doc != "Adds two numbers." {
+		t.Errorf("got Doc %q", r.Doc)
+	}
+}
+
+func TestPythonExtractorClassMultiLineDocstring(t *testing.T) {
+	src := "class Point:\n    \"\"\"\n    Represents a point.\n    \"\"\"\n    pass\n"
+	records := extractPython(t, src)
+	r, ok := findRecord(records, "Point")
+	if !ok {
+		t.Fatalf("Point missing from records: %+v", records)
+	}
+	if r.Kind != KindType {
+		t.Errorf("got Kind %q, want %q", r.Kind, KindType)
+	}
+	if r.Doc != "Represents a point." {
+		t.Errorf("got Doc %q", r.Doc)
+	}
+}
+
+func TestPythonExtractorNoDocstringYieldsEmptyDoc(t *testing.T) {
+	records := extractPython(t, "def undocumented():\n    return 1\n")
+	r, ok := findRecord(records, "undocumented")
+	if !ok {
+		t.Fatalf("undocumented missing from records: %+v", records)
+	}
+	if r.Doc != "" {
+		t.Errorf("got Doc %q, want empty", r.Doc)
+	}
+}
@@ -0,0 +1,208 @@
+package docs
+
+import (
+	"encoding/json"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"bxfferoverflow.me/code-stats/internal/report"
+)
+
+type fileEntry struct {
+	File  string
+	Slug  string
+	Count int
+}
+
+type indexView struct {
+	Summary report.Summary
+	Files   []fileEntry
+}
+
+type pageView struct {
+	File    string
+	Records []Record
+}
+
+// GenerateSite renders an index page plus one page per file under
+// outDir, reusing report.Style so the docs site and the stats HTML
+// report read as one product.
+func GenerateSite(outDir string, byFile map[string][]Record, summary report.Summary) error {
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return err
+	}
+
+	files := sortedFiles(byFile)
+	entries := make([]fileEntry, 0, len(files))
+	for _, f := range files {
+		entries = append(entries, fileEntry{File: f, Slug: slugify(f), Count: len(byFile[f])})
+	}
+
+	indexTpl := template.Must(template.New("index").Parse(indexTemplate))
+	idx, err := os.Create(filepath.Join(outDir, "index.html"))
+	if err != nil {
+		return err
+	}
+	defer idx.Close()
+	if err := indexTpl.Execute(idx, indexView{Summary: summary, Files: entries}); err != nil {
+		return err
+	}
+
+	pageTpl := template.Must(template.New("page").Parse(pageTemplate))
+	for _, f := range files {
+		pagePath := filepath.Join(outDir, slugify(f)+".html")
+		pf, err := os.Create(pagePath)
+		if err != nil {
+			return err
+		}
+		err = pageTpl.Execute(pf, pageView{File: f, Records: byFile[f]})
+		pf.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateJSON writes the extracted records as a single docs.json file
+// under outDir, for downstream tooling that would rather consume
+// structured data than the HTML site.
+func GenerateJSON(outDir string, byFile map[string][]Record, summary report.Summary) error {
+	if err := os.MkdirAll(outDir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(outDir, "docs.json"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Summary report.Summary      `json:"summary"`
+		Files   map[string][]Record `json:"files"`
+	}{summary, byFile})
+}
+
+func sortedFiles(byFile map[string][]Record) []string {
+	files := make([]string, 0, len(byFile))
+	for f := range byFile {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	return files
+}
+
+func slugify(path string) string {
+	s := strings.ReplaceAll(path, string(filepath.Separator), "_")
+	return strings.ReplaceAll(s, ".", "_")
+}
+
+const indexTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Code Stats &mdash; Docs</title>
+    <link href="https://fonts.googleapis.com/css2?family=Inter:wght@400;600;700&display=swap" rel="stylesheet">
+    <style>` + report.Style + `</style>
+</head>
+<body>
+    <header>
+        <h1>Project Docs</h1>
+        <div>Generated by code-stats</div>
+    </header>
+    <main>
+        <div class="card">
+            <h2>Stats Summary</h2>
+            <table class="summary-table">
+                <thead>
+                    <tr>
+                        <th>Total Files</th>
+                        <th>Total Lines</th>
+                        <th>Total Comment Lines</th>
+                        <th>Total Mixed Lines</th>
+                        <th>Total Empty Lines</th>
+                        <th>Avg Lines/File</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    <tr>
+                        <td>{{.Summary.TotalFiles}}</td>
+                        <td>{{.Summary.TotalLines}}</td>
+                        <td>{{.Summary.TotalCommentLines}}</td>
+                        <td>{{.Summary.TotalMixedLines}}</td>
+                        <td>{{.Summary.TotalEmptyLines}}</td>
+                        <td>{{printf "%.2f" .Summary.AvgLinesPerFile}}</td>
+                    </tr>
+                </tbody>
+            </table>
+        </div>
+        <div class="card">
+            <h2>Files</h2>
+            <table>
+                <thead>
+                    <tr>
+                        <th>File</th>
+                        <th>Documented Declarations</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range .Files}}
+                    <tr>
+                        <td><a href="{{.Slug}}.html">{{.File}}</a></td>
+                        <td>{{.Count}}</td>
+                    </tr>
+                    {{end}}
+                </tbody>
+            </table>
+        </div>
+    </main>
+</body>
+</html>`
+
+const pageTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>{{.File}} &mdash; Code Stats Docs</title>
+    <link href="https://fonts.googleapis.com/css2?family=Inter:wght@400;600;700&display=swap" rel="stylesheet">
+    <style>` + report.Style + `</style>
+</head>
+<body>
+    <header>
+        <h1>{{.File}}</h1>
+        <div><a href="index.html">&larr; back to index</a></div>
+    </header>
+    <main>
+        <div class="card">
+            <table>
+                <thead>
+                    <tr>
+                        <th>Kind</th>
+                        <th>Name</th>
+                        <th>Signature</th>
+                        <th>Line</th>
+                        <th>Doc</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range .Records}}
+                    <tr>
+                        <td>{{.Kind}}</td>
+                        <td>{{.Name}}</td>
+                        <td><code>{{.Signature}}</code></td>
+                        <td>{{.Line}}</td>
+                        <td>{{.Doc}}</td>
+                    </tr>
+                    {{end}}
+                </tbody>
+            </table>
+        </div>
+    </main>
+</body>
+</html>`
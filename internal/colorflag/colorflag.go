@@ -0,0 +1,51 @@
+// Package colorflag resolves the tri-state --color=auto|always|never flag
+// shared by every command that can emit ANSI color.
+package colorflag
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+)
+
+// Modes lists the valid --color values, in the order they should be
+// presented in help text.
+var Modes = []string{"auto", "always", "never"}
+
+// Valid reports whether mode is one of Modes.
+func Valid(mode string) bool {
+	for _, m := range Modes {
+		if mode == m {
+			return true
+		}
+	}
+	return false
+}
+
+// Enabled resolves mode against out: "always" and "never" are absolute,
+// "auto" enables color only when out is a terminal and the user hasn't
+// set NO_COLOR, mirroring the convention FORCE_COLOR/NO_COLOR-aware CLIs
+// use. FORCE_COLOR overrides a non-terminal out the same way "always"
+// would, so piping into `cat` doesn't silently swallow an explicit ask.
+func Enabled(mode string, out *os.File) bool {
+	switch mode {
+	case "always":
+		return true
+	case "never":
+		return false
+	default:
+		if os.Getenv("NO_COLOR") != "" {
+			return false
+		}
+		if os.Getenv("FORCE_COLOR") != "" {
+			return true
+		}
+		return isatty.IsTerminal(out.Fd()) || isatty.IsCygwinTerminal(out.Fd())
+	}
+}
+
+// ErrInvalidMode formats the error for an unrecognized --color value.
+func ErrInvalidMode(mode string) error {
+	return fmt.Errorf("invalid --color value %q (want one of: %v)", mode, Modes)
+}
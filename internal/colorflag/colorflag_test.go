@@ -0,0 +1,67 @@
+package colorflag
+
+import (
+	"os"
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	for _, mode := range Modes {
+		if !Valid(mode) {
+			t.Errorf("Valid(%q) = false, want true", mode)
+		}
+	}
+	if Valid("sometimes") {
+		t.Error("Valid(\"sometimes\") = true, want false")
+	}
+}
+
+func TestEnabledAlwaysAndNeverAreAbsolute(t *testing.T) {
+	if !Enabled("always", os.Stdin) {
+		t.Error("always should enable color even on a non-terminal file")
+	}
+	if Enabled("never", os.Stdout) {
+		t.Error("never should disable color regardless of terminal state")
+	}
+}
+
+func TestEnabledAutoRespectsNoColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	t.Setenv("FORCE_COLOR", "")
+	if Enabled("auto", os.Stdout) {
+		t.Error("NO_COLOR should disable color even if out were a terminal")
+	}
+}
+
+func TestEnabledAutoRespectsForceColor(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("FORCE_COLOR", "1")
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatalf("CreateTemp: %v", err)
+	}
+	defer f.Close()
+	if !Enabled("auto", f) {
+		t.Error("FORCE_COLOR should enable color even on a non-terminal file")
+	}
+}
+
+func TestEnabledAutoFallsBackToIsTerminal(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("FORCE_COLOR", "")
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	if err != nil {
+		t.Fatalf("CreateTemp: %v", err)
+	}
+	defer f.Close()
+	if Enabled("auto", f) {
+		t.Error("a plain file is not a terminal, so auto should disable color")
+	}
+}
+
+func TestErrInvalidMode(t *testing.T) {
+	err := ErrInvalidMode("bogus")
+	if err == nil {
+		t.Fatal("expected a non-nil error")
+	}
+}
@@ -0,0 +1,42 @@
+package report
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// csvFormatter renders Data as delimited text; delim is ',' for csv and
+// '\t' for tsv, the only difference between the two registered formats.
+type csvFormatter struct {
+	delim rune
+}
+
+func (f csvFormatter) Format(w io.Writer, data Data, _ Options) error {
+	csvw := csv.NewWriter(w)
+	csvw.Comma = f.delim
+	csvw.Write([]string{"Extension", "File Count", "Total Lines", "Comment Lines", "Mixed Lines", "Empty Lines", "Avg Lines/File"})
+	for _, s := range data.Stats {
+		csvw.Write([]string{
+			s.Extension,
+			fmt.Sprintf("%d", s.FileCount),
+			fmt.Sprintf("%d", s.TotalLines),
+			fmt.Sprintf("%d", s.CommentLines),
+			fmt.Sprintf("%d", s.MixedLines),
+			fmt.Sprintf("%d", s.EmptyLines),
+			formatAvg(s.AvgLinesPerFile),
+		})
+	}
+	csvw.Write([]string{})
+	csvw.Write([]string{"Total Files", "Total Lines", "Total Comment Lines", "Total Mixed Lines", "Total Empty Lines", "Avg Lines/File"})
+	csvw.Write([]string{
+		fmt.Sprintf("%d", data.Summary.TotalFiles),
+		fmt.Sprintf("%d", data.Summary.TotalLines),
+		fmt.Sprintf("%d", data.Summary.TotalCommentLines),
+		fmt.Sprintf("%d", data.Summary.TotalMixedLines),
+		fmt.Sprintf("%d", data.Summary.TotalEmptyLines),
+		formatAvg(data.Summary.AvgLinesPerFile),
+	})
+	csvw.Flush()
+	return csvw.Error()
+}
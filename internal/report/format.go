@@ -0,0 +1,7 @@
+package report
+
+import "fmt"
+
+func formatAvg(avg float64) string {
+	return fmt.Sprintf("%.2f", avg)
+}
@@ -0,0 +1,184 @@
+package report
+
+import (
+	"html/template"
+	"io"
+	"time"
+)
+
+type htmlFormatter struct{}
+
+func (htmlFormatter) Format(w io.Writer, data Data, _ Options) error {
+	t := template.Must(template.New("stats").Funcs(template.FuncMap{
+		"now":  func() string { return time.Now().Format("02.01.2006 15:04") },
+		"year": func() int { return time.Now().Year() },
+	}).Parse(htmlTemplate))
+	return t.Execute(w, data)
+}
+
+// Style is the Inter/card look shared by every HTML page code-stats
+// generates, so the docs site and the stats report read as one product.
+const Style = `
+        :root {
+            --primary: #2563eb;
+            --secondary: #38a169;
+            --bg: #f8fafc;
+            --card-bg: #fff;
+            --border: #e2e8f0;
+            --header: #1e293b;
+            --shadow: 0 4px 24px #0002;
+        }
+        html { box-sizing: border-box; }
+        *, *:before, *:after { box-sizing: inherit; }
+        body {
+            font-family: 'Inter', system-ui, sans-serif;
+            background: var(--bg);
+            color: #222;
+            margin: 0;
+            padding: 0;
+        }
+        header {
+            background: var(--primary);
+            color: #fff;
+            padding: 2rem 1rem 1.5rem 1rem;
+            text-align: center;
+            box-shadow: var(--shadow);
+        }
+        header h1 {
+            margin: 0;
+            font-size: 2.5rem;
+            font-weight: 700;
+            letter-spacing: -1px;
+        }
+        main {
+            max-width: 900px;
+            margin: -2rem auto 0 auto;
+            padding: 2rem 1rem 3rem 1rem;
+        }
+        .card {
+            background: var(--card-bg);
+            border-radius: 1.2rem;
+            box-shadow: var(--shadow);
+            padding: 2rem 1.5rem;
+            margin-bottom: 2.5rem;
+        }
+        h2 {
+            color: var(--header);
+            font-size: 1.4rem;
+            font-weight: 600;
+            margin-top: 0;
+        }
+        table {
+            border-collapse: collapse;
+            width: 100%;
+            background: var(--card-bg);
+            border-radius: 0.7rem;
+            overflow: hidden;
+            box-shadow: 0 2px 8px #0001;
+        }
+        th, td {
+            border: 1px solid var(--border);
+            padding: 0.7rem 1rem;
+            text-align: left;
+        }
+        th {
+            background: var(--primary);
+            color: #fff;
+            font-weight: 600;
+            font-size: 1rem;
+            letter-spacing: 0.5px;
+        }
+        .summary-table th { background: var(--secondary); }
+        tr:nth-child(even) td { background: #f1f5f9; }
+        tr:hover td { background: #e0e7ef; transition: background 0.2s; }
+        @media (max-width: 700px) {
+            main { padding: 1rem 0.2rem; }
+            .card { padding: 1rem 0.5rem; }
+            th, td { padding: 0.5rem 0.4rem; font-size: 0.95rem; }
+            header h1 { font-size: 1.5rem; }
+        }
+        footer {
+            text-align: center;
+            color: #64748b;
+            font-size: 0.95rem;
+            padding: 1.5rem 0 0.7rem 0;
+        }
+        a { color: var(--primary); text-decoration: none; }
+        a:hover { text-decoration: underline; }
+`
+
+const htmlTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <title>Code Stats Report</title>
+    <link href="https://fonts.googleapis.com/css2?family=Inter:wght@400;600;700&display=swap" rel="stylesheet">
+    <style>` + Style + `</style>
+</head>
+<body>
+    <header>
+        <h1>Code Stats Report</h1>
+        <div>Automatically generated &bull; {{ now }}</div>
+    </header>
+    <main>
+        <div class="card">
+            <h2>Per Extension</h2>
+            <table>
+                <thead>
+                    <tr>
+                        <th>Extension</th>
+                        <th>File Count</th>
+                        <th>Total Lines</th>
+                        <th>Comment Lines</th>
+                        <th>Mixed Lines</th>
+                        <th>Empty Lines</th>
+                        <th>Avg Lines/File</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    {{range .Stats}}
+                    <tr>
+                        <td>{{.Extension}}</td>
+                        <td>{{.FileCount}}</td>
+                        <td>{{.TotalLines}}</td>
+                        <td>{{.CommentLines}}</td>
+                        <td>{{.MixedLines}}</td>
+                        <td>{{.EmptyLines}}</td>
+                        <td>{{printf "%.2f" .AvgLinesPerFile}}</td>
+                    </tr>
+                    {{end}}
+                </tbody>
+            </table>
+        </div>
+        <div class="card">
+            <h2>Summary</h2>
+            <table class="summary-table">
+                <thead>
+                    <tr>
+                        <th>Total Files</th>
+                        <th>Total Lines</th>
+                        <th>Total Comment Lines</th>
+                        <th>Total Mixed Lines</th>
+                        <th>Total Empty Lines</th>
+                        <th>Avg Lines/File</th>
+                    </tr>
+                </thead>
+                <tbody>
+                    <tr>
+                        <td>{{.Summary.TotalFiles}}</td>
+                        <td>{{.Summary.TotalLines}}</td>
+                        <td>{{.Summary.TotalCommentLines}}</td>
+                        <td>{{.Summary.TotalMixedLines}}</td>
+                        <td>{{.Summary.TotalEmptyLines}}</td>
+                        <td>{{printf "%.2f" .Summary.AvgLinesPerFile}}</td>
+                    </tr>
+                </tbody>
+            </table>
+        </div>
+    </main>
+    <footer>
+        &copy; {{ year }} Code Stats &mdash; Generated with <a href="https://github.com/jedib0t/go-pretty">go-pretty</a> and <a href="https://github.com/spf13/cobra">Cobra</a>
+    </footer>
+</body>
+</html>`
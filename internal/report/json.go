@@ -0,0 +1,14 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+)
+
+type jsonFormatter struct{}
+
+func (jsonFormatter) Format(w io.Writer, data Data, _ Options) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(data)
+}
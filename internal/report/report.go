@@ -0,0 +1,69 @@
+// Package report renders code-stats results in a chosen output format.
+package report
+
+import (
+	"fmt"
+	"io"
+)
+
+// Stats is the per-extension row of a report.
+type Stats struct {
+	Extension       string  `json:"extension" yaml:"extension"`
+	FileCount       int64   `json:"file_count" yaml:"file_count"`
+	TotalLines      int64   `json:"total_lines" yaml:"total_lines"`
+	CommentLines    int64   `json:"comment_lines" yaml:"comment_lines"`
+	MixedLines      int64   `json:"mixed_lines" yaml:"mixed_lines"`
+	EmptyLines      int64   `json:"empty_lines" yaml:"empty_lines"`
+	AvgLinesPerFile float64 `json:"avg_lines_per_file" yaml:"avg_lines_per_file"`
+}
+
+// Summary is the repo-wide totals row of a report.
+type Summary struct {
+	TotalFiles        int64   `json:"total_files" yaml:"total_files"`
+	TotalLines        int64   `json:"total_lines" yaml:"total_lines"`
+	TotalCommentLines int64   `json:"total_comment_lines" yaml:"total_comment_lines"`
+	TotalMixedLines   int64   `json:"total_mixed_lines" yaml:"total_mixed_lines"`
+	TotalEmptyLines   int64   `json:"total_empty_lines" yaml:"total_empty_lines"`
+	AvgLinesPerFile   float64 `json:"avg_lines_per_file" yaml:"avg_lines_per_file"`
+}
+
+// Data is the full payload a Formatter renders: one Stats row per
+// extension plus the overall Summary.
+type Data struct {
+	Stats   []Stats `json:"stats" yaml:"stats"`
+	Summary Summary `json:"summary" yaml:"summary"`
+}
+
+// Options controls formatting that isn't part of the data itself, such as
+// whether the table renderer is allowed to emit ANSI color.
+type Options struct {
+	UseColor bool
+}
+
+// Formatter renders Data to w.
+type Formatter interface {
+	Format(w io.Writer, data Data, opts Options) error
+}
+
+var formatters = map[string]Formatter{
+	"table":  tableFormatter{},
+	"simple": simpleFormatter{},
+	"csv":    csvFormatter{delim: ','},
+	"tsv":    csvFormatter{delim: '\t'},
+	"json":   jsonFormatter{},
+	"yaml":   yamlFormatter{},
+	"html":   htmlFormatter{},
+}
+
+// Names lists the valid --format values, in the order they should be
+// presented in help text.
+var Names = []string{"table", "simple", "csv", "tsv", "json", "yaml", "html"}
+
+// Get returns the Formatter registered for name.
+func Get(name string) (Formatter, error) {
+	f, ok := formatters[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown format %q (want one of: %v)", name, Names)
+	}
+	return f, nil
+}
@@ -0,0 +1,86 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func sampleData() Data {
+	return Data{
+		Stats: []Stats{
+			{Extension: ".go", FileCount: 3, TotalLines: 120, CommentLines: 20, MixedLines: 5, EmptyLines: 10, AvgLinesPerFile: 40},
+		},
+		Summary: Summary{TotalFiles: 3, TotalLines: 120, TotalCommentLines: 20, TotalMixedLines: 5, TotalEmptyLines: 10, AvgLinesPerFile: 40},
+	}
+}
+
+func TestGetUnknownFormat(t *testing.T) {
+	if _, err := Get("xml"); err == nil {
+		t.Fatal("expected an error for an unregistered format")
+	}
+}
+
+func TestGetReturnsAllNames(t *testing.T) {
+	for _, name := range Names {
+		if _, err := Get(name); err != nil {
+			t.Errorf("Get(%q): %v", name, err)
+		}
+	}
+}
+
+func TestFormattersRenderExtensionAndTotals(t *testing.T) {
+	data := sampleData()
+	for _, name := range Names {
+		t.Run(name, func(t *testing.T) {
+			formatter, err := Get(name)
+			if err != nil {
+				t.Fatalf("Get(%q): %v", name, err)
+			}
+			var buf bytes.Buffer
+			if err := formatter.Format(&buf, data, Options{}); err != nil {
+				t.Fatalf("Format: %v", err)
+			}
+			out := buf.String()
+			if !strings.Contains(out, ".go") {
+				t.Errorf("%s output missing extension: %q", name, out)
+			}
+			if !strings.Contains(out, "3") {
+				t.Errorf("%s output missing file count: %q", name, out)
+			}
+		})
+	}
+}
+
+func TestSimpleFormatterDoesNotEmitLiteralTabs(t *testing.T) {
+	var buf bytes.Buffer
+	if err := (simpleFormatter{}).Format(&buf, sampleData(), Options{}); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if strings.Contains(buf.String(), "\t") {
+		t.Error("simple is space-padded for terminal viewing, not a tab-delimited format; use tsv for that")
+	}
+}
+
+func TestTableFormatterColorsExtensionWhenEnabled(t *testing.T) {
+	// color detects NoColor from the terminal at init time, which is false
+	// in a test binary's stdout; force it on so Options.UseColor is what's
+	// actually under test.
+	old := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = old }()
+
+	var plain, colored bytes.Buffer
+	formatter := tableFormatter{}
+	if err := formatter.Format(&plain, sampleData(), Options{UseColor: false}); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if err := formatter.Format(&colored, sampleData(), Options{UseColor: true}); err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	if plain.String() == colored.String() {
+		t.Error("expected UseColor to change table output")
+	}
+}
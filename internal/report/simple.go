@@ -0,0 +1,29 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// simpleFormatter renders Data as plain, space-aligned columns with no
+// borders, via text/tabwriter. The columns are padded for readability, not
+// delimited by literal tabs, so it isn't something `cut -f`/`awk -F'\t'`
+// can split on — use the tsv format for that.
+type simpleFormatter struct{}
+
+func (simpleFormatter) Format(w io.Writer, data Data, _ Options) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "Extension\tFile Count\tTotal Lines\tComment Lines\tMixed Lines\tEmpty Lines\tAvg Lines/File")
+	for _, s := range data.Stats {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\t%s\n", s.Extension, s.FileCount, s.TotalLines, s.CommentLines, s.MixedLines, s.EmptyLines, formatAvg(s.AvgLinesPerFile))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	sumTw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(sumTw, "Total Files\tTotal Lines\tTotal Comment Lines\tTotal Mixed Lines\tTotal Empty Lines\tAvg Lines/File")
+	fmt.Fprintf(sumTw, "%d\t%d\t%d\t%d\t%d\t%s\n", data.Summary.TotalFiles, data.Summary.TotalLines, data.Summary.TotalCommentLines, data.Summary.TotalMixedLines, data.Summary.TotalEmptyLines, formatAvg(data.Summary.AvgLinesPerFile))
+	return sumTw.Flush()
+}
@@ -0,0 +1,66 @@
+package report
+
+import (
+	"io"
+
+	"github.com/fatih/color"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// tableFormatter renders Data as two bordered tables, matching the
+// original console output: one row per extension, then a totals row.
+type tableFormatter struct{}
+
+func (tableFormatter) Format(w io.Writer, data Data, opts Options) error {
+	t := table.NewWriter()
+	t.SetOutputMirror(w)
+	extHeader := table.Row{"Extension", "File Count", "Total Lines", "Comment Lines", "Mixed Lines", "Empty Lines", "Avg Lines/File"}
+	if opts.UseColor {
+		cyan := color.New(color.FgCyan, color.Bold).SprintFunc()
+		for i, h := range extHeader {
+			if s, ok := h.(string); ok {
+				extHeader[i] = cyan(s)
+			}
+		}
+	}
+	t.AppendHeader(extHeader)
+	for _, s := range data.Stats {
+		row := table.Row{
+			s.Extension,
+			s.FileCount,
+			s.TotalLines,
+			s.CommentLines,
+			s.MixedLines,
+			s.EmptyLines,
+			formatAvg(s.AvgLinesPerFile),
+		}
+		if opts.UseColor {
+			row[0] = color.New(color.FgGreen, color.Bold).Sprint(row[0])
+		}
+		t.AppendRow(row)
+	}
+	t.Render()
+
+	sumT := table.NewWriter()
+	sumT.SetOutputMirror(w)
+	sumHeader := table.Row{"Total Files", "Total Lines", "Total Comment Lines", "Total Mixed Lines", "Total Empty Lines", "Avg Lines/File"}
+	if opts.UseColor {
+		magenta := color.New(color.FgMagenta, color.Bold).SprintFunc()
+		for i, h := range sumHeader {
+			if s, ok := h.(string); ok {
+				sumHeader[i] = magenta(s)
+			}
+		}
+	}
+	sumT.AppendHeader(sumHeader)
+	sumT.AppendRow(table.Row{
+		data.Summary.TotalFiles,
+		data.Summary.TotalLines,
+		data.Summary.TotalCommentLines,
+		data.Summary.TotalMixedLines,
+		data.Summary.TotalEmptyLines,
+		formatAvg(data.Summary.AvgLinesPerFile),
+	})
+	sumT.Render()
+	return nil
+}
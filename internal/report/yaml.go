@@ -0,0 +1,15 @@
+package report
+
+import (
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+type yamlFormatter struct{}
+
+func (yamlFormatter) Format(w io.Writer, data Data, _ Options) error {
+	enc := yaml.NewEncoder(w)
+	defer enc.Close()
+	return enc.Encode(data)
+}
@@ -0,0 +1,251 @@
+// Package scan walks a directory tree, classifies each file with
+// parser.Analyze, and folds the results into a report.Data payload. It is
+// the shared engine behind the scan and watch subcommands.
+package scan
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"sync"
+
+	"bxfferoverflow.me/code-stats/docs"
+	"bxfferoverflow.me/code-stats/internal/report"
+	"bxfferoverflow.me/code-stats/parser"
+)
+
+// DefaultExtensions is the file-extension allowlist used when the caller
+// doesn't pass its own via --ext.
+var DefaultExtensions = []string{".go", ".rs", ".js", ".ts", ".py", ".rb", ".c", ".h", ".cpp", ".hpp", ".cs", ".html", ".css", ".json", ".md", ".txt", ".yaml", ".yml", ".toml", ".ini", ".env", ".sh", ".bash", ".zsh", ".fish", ".ps1", ".psm1", ".psd1", ".pssc", ".psscx", ".psscy", ".psscz", ".pssc0", ".pssc1", ".pssc2", ".pssc3", ".pssc4", ".pssc5", ".pssc6", ".pssc7", ".pssc8", ".pssc9", ".pssc10"}
+
+// DefaultIgnoreDirs is the directory denylist used when the caller doesn't
+// pass its own via --ignore.
+var DefaultIgnoreDirs = []string{".git", ".idea", ".vscode", ".DS_Store", "build", "dist", "node_modules", "vendor", "tmp", "logs", "cache", ".next", ".venv"}
+
+// fileEntry is a single file's last-seen contribution to an Index, kept so
+// a later UpsertFile can subtract it before adding the fresh one.
+type fileEntry struct {
+	ext   string
+	stats parser.Stats
+}
+
+// extTotals are the running per-extension totals behind an Index.
+type extTotals struct {
+	files   int64
+	lines   int64
+	comment int64
+	mixed   int64
+	empty   int64
+}
+
+// Index accumulates per-file Stats into per-extension and overall totals.
+// Unlike a one-shot counter, it supports UpsertFile/RemoveFile so a caller
+// (the watch subcommand) can keep a long-lived Index in sync with a
+// changing tree instead of rescanning it from scratch.
+type Index struct {
+	mu        sync.Mutex
+	byExt     map[string]extTotals
+	fileStats map[string]fileEntry
+}
+
+// NewIndex returns an empty Index.
+func NewIndex() *Index {
+	return &Index{
+		byExt:     make(map[string]extTotals),
+		fileStats: make(map[string]fileEntry),
+	}
+}
+
+// FileStats is one file's analyzed contribution to an Index, passed as a
+// single value so applyFileStats only needs the aggregate lock once per
+// file rather than once per field.
+type FileStats struct {
+	Path  string
+	Ext   string
+	Stats parser.Stats
+}
+
+func (idx *Index) applyFileStats(f FileStats, sign int64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	t := idx.byExt[f.Ext]
+	t.files += sign
+	t.lines += sign * int64(f.Stats.TotalLines)
+	t.comment += sign * int64(f.Stats.CommentLines)
+	t.mixed += sign * int64(f.Stats.MixedLines)
+	t.empty += sign * int64(f.Stats.BlankLines)
+	idx.byExt[f.Ext] = t
+}
+
+// UpsertFile analyzes the file at path and folds its Stats into the
+// Index, subtracting any previous contribution from that same path first.
+func (idx *Index) UpsertFile(path, ext string) error {
+	stats, err := parser.Analyze(path, ext)
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	old, existed := idx.fileStats[path]
+	idx.fileStats[path] = fileEntry{ext: ext, stats: stats}
+	idx.mu.Unlock()
+
+	if existed {
+		idx.applyFileStats(FileStats{Path: path, Ext: old.ext, Stats: old.stats}, -1)
+	}
+	idx.applyFileStats(FileStats{Path: path, Ext: ext, Stats: stats}, 1)
+	return nil
+}
+
+// RemoveFile subtracts path's last-known contribution from the Index, if
+// any, and forgets it. It is a no-op for paths the Index never saw.
+func (idx *Index) RemoveFile(path string) {
+	idx.mu.Lock()
+	old, existed := idx.fileStats[path]
+	if existed {
+		delete(idx.fileStats, path)
+	}
+	idx.mu.Unlock()
+
+	if existed {
+		idx.applyFileStats(FileStats{Path: path, Ext: old.ext, Stats: old.stats}, -1)
+	}
+}
+
+// Data snapshots the Index's current totals as a report.Data payload.
+func (idx *Index) Data() report.Data {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	var stats []report.Stats
+	var totalFiles, totalLines, totalComment, totalMixed, totalEmpty int64
+	for ext, t := range idx.byExt {
+		avg := float64(0)
+		if t.files > 0 {
+			avg = float64(t.lines) / float64(t.files)
+		}
+		stats = append(stats, report.Stats{
+			Extension:       ext,
+			FileCount:       t.files,
+			TotalLines:      t.lines,
+			CommentLines:    t.comment,
+			MixedLines:      t.mixed,
+			EmptyLines:      t.empty,
+			AvgLinesPerFile: avg,
+		})
+		totalFiles += t.files
+		totalLines += t.lines
+		totalComment += t.comment
+		totalMixed += t.mixed
+		totalEmpty += t.empty
+	}
+
+	avg := float64(0)
+	if totalFiles > 0 {
+		avg = float64(totalLines) / float64(totalFiles)
+	}
+	return report.Data{
+		Stats: stats,
+		Summary: report.Summary{
+			TotalFiles:        totalFiles,
+			TotalLines:        totalLines,
+			TotalCommentLines: totalComment,
+			TotalMixedLines:   totalMixed,
+			TotalEmptyLines:   totalEmpty,
+			AvgLinesPerFile:   avg,
+		},
+	}
+}
+
+// Options controls a single directory walk.
+type Options struct {
+	Extensions   []string
+	IgnoreDirs   []string
+	ShowProgress bool
+	DocCollector *docs.Collector
+	// Jobs caps how many files are analyzed concurrently. Zero (the
+	// default) falls back to runtime.NumCPU(), which keeps every core
+	// busy without spawning an unbounded goroutine per file.
+	Jobs int
+}
+
+type walkJob struct {
+	path, ext string
+}
+
+// Walk scans dir with a single filepath.WalkDir walker feeding a bounded
+// pool of opts.Jobs workers, so file-analysis concurrency no longer
+// scales with tree size (one goroutine per file) or depth (one per
+// directory).
+func (idx *Index) Walk(dir string, opts Options) {
+	jobs := opts.Jobs
+	if jobs <= 0 {
+		jobs = runtime.NumCPU()
+	}
+
+	jobCh := make(chan walkJob)
+	var workers sync.WaitGroup
+	for i := 0; i < jobs; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for j := range jobCh {
+				idx.processFile(j.path, j.ext, opts)
+			}
+		}()
+	}
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			fmt.Println("Error reading path:", path, err)
+			return nil
+		}
+		if d.IsDir() {
+			if path != dir && shouldIgnoreDir(d.Name(), opts.IgnoreDirs) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		ext := filepath.Ext(path)
+		if !slices.Contains(opts.Extensions, ext) {
+			return nil
+		}
+		jobCh <- walkJob{path: path, ext: ext}
+		return nil
+	})
+	close(jobCh)
+	workers.Wait()
+
+	if err != nil {
+		fmt.Println("Error walking directory:", dir, err)
+	}
+}
+
+func (idx *Index) processFile(path, ext string, opts Options) {
+	if err := idx.UpsertFile(path, ext); err != nil {
+		fmt.Println("Error reading file:", path, err)
+		return
+	}
+	if opts.ShowProgress {
+		fmt.Printf("Processing: %s\n", path)
+	}
+	if opts.DocCollector != nil && docs.Supports(ext) {
+		records, err := docs.Extract(path, ext)
+		if err != nil {
+			fmt.Println("Error extracting docs:", path, err)
+			return
+		}
+		opts.DocCollector.Add(path, records)
+	}
+}
+
+func shouldIgnoreDir(name string, ignoreList []string) bool {
+	for _, ignore := range ignoreList {
+		if name == ignore {
+			return true
+		}
+	}
+	return false
+}
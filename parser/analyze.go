@@ -0,0 +1,261 @@
+package parser
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// LineKind classifies a single line of source according to the state
+// machine in Analyze.
+type LineKind int
+
+const (
+	Blank LineKind = iota
+	CommentOnly
+	Mixed
+	CodeOnly
+)
+
+// Stats is the per-file result of a single Analyze pass.
+type Stats struct {
+	TotalLines   int
+	CodeLines    int
+	CommentLines int
+	MixedLines   int
+	BlankLines   int
+}
+
+// scanState carries the state machine's position across lines: whether it
+// is currently inside a block comment, a (possibly multi-line) string, or
+// a shell heredoc body.
+type scanState struct {
+	inBlockComment   bool
+	blockEnd         string
+	inString         bool
+	stringDelim      string
+	stringEscape     string
+	inHeredoc        bool
+	heredocDelim     string
+	heredocStripTabs bool
+}
+
+// Analyze reads the file at path once and classifies every line as blank,
+// pure comment, mixed code+comment, or pure code, using the token table
+// registered for ext in Languages. Extensions with no registered LangSpec
+// are treated as having no comment or string syntax, so every non-blank
+// line counts as code.
+func Analyze(path, ext string) (Stats, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return Stats{}, err
+	}
+	defer f.Close()
+
+	spec := Languages[ext]
+	st := &scanState{}
+
+	var stats Stats
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		stats.TotalLines++
+		switch classifyLine(scanner.Text(), spec, st) {
+		case Blank:
+			stats.BlankLines++
+		case CommentOnly:
+			stats.CommentLines++
+		case Mixed:
+			stats.MixedLines++
+		case CodeOnly:
+			stats.CodeLines++
+		}
+	}
+	return stats, scanner.Err()
+}
+
+type tokenKind int
+
+const (
+	tokenNone tokenKind = iota
+	tokenLineComment
+	tokenBlockStart
+	tokenStringStart
+	tokenHeredocStart
+)
+
+// classifyLine advances the state machine by one line and reports its
+// LineKind. It mutates st when a block comment or string is left open at
+// end of line, so it must be called once per line in file order.
+func classifyLine(line string, spec LangSpec, st *scanState) LineKind {
+	hasCode, hasComment := false, false
+	n := len(line)
+	i := 0
+
+	for i < n {
+		if st.inHeredoc {
+			hasCode = true
+			body := line[i:]
+			if st.heredocStripTabs {
+				body = strings.TrimLeft(body, "\t")
+			}
+			if body == st.heredocDelim {
+				st.inHeredoc = false
+				st.heredocDelim = ""
+				st.heredocStripTabs = false
+			}
+			i = n
+			continue
+		}
+
+		if st.inBlockComment {
+			hasComment = true
+			if idx := strings.Index(line[i:], st.blockEnd); idx >= 0 {
+				i += idx + len(st.blockEnd)
+				st.inBlockComment = false
+				st.blockEnd = ""
+			} else {
+				i = n
+			}
+			continue
+		}
+
+		if st.inString {
+			hasCode = true
+			consumed, closed := consumeString(line[i:], st.stringDelim, st.stringEscape)
+			i += consumed
+			if closed {
+				st.inString = false
+				st.stringDelim = ""
+				st.stringEscape = ""
+			}
+			if consumed == 0 {
+				i = n
+			}
+			continue
+		}
+
+		idx, kind, tokLen, payload, escape := nextToken(line[i:], spec)
+		if idx < 0 {
+			if strings.TrimSpace(line[i:]) != "" {
+				hasCode = true
+			}
+			break
+		}
+		if strings.TrimSpace(line[i:i+idx]) != "" {
+			hasCode = true
+		}
+
+		switch kind {
+		case tokenLineComment:
+			hasComment = true
+			i = n
+		case tokenBlockStart:
+			hasComment = true
+			st.inBlockComment = true
+			st.blockEnd = payload
+			i += idx + tokLen
+		case tokenStringStart:
+			hasCode = true
+			st.inString = true
+			st.stringDelim = payload
+			st.stringEscape = escape
+			i += idx + tokLen
+		case tokenHeredocStart:
+			hasCode = true
+			st.inHeredoc = true
+			st.heredocDelim = payload
+			st.heredocStripTabs = escape == "-"
+			i = n
+		}
+	}
+
+	switch {
+	case !hasCode && !hasComment:
+		return Blank
+	case hasComment && !hasCode:
+		return CommentOnly
+	case hasCode && hasComment:
+		return Mixed
+	default:
+		return CodeOnly
+	}
+}
+
+// nextToken scans s for the earliest line-comment token, block-comment
+// start, or string delimiter (optionally preceded by a raw-string prefix)
+// registered in spec. It returns idx -1 if none occur in s.
+func nextToken(s string, spec LangSpec) (idx int, kind tokenKind, tokLen int, payload, escape string) {
+	best := -1
+
+	consider := func(at int, k tokenKind, length int, pay, esc string) {
+		if at < 0 {
+			return
+		}
+		if best == -1 || at < best || (at == best && length > tokLen) {
+			best = at
+			idx, kind, tokLen, payload, escape = at, k, length, pay, esc
+		}
+	}
+
+	for _, tok := range spec.LineComments {
+		consider(strings.Index(s, tok), tokenLineComment, len(tok), tok, "")
+	}
+	for _, bc := range spec.BlockComments {
+		consider(strings.Index(s, bc.Start), tokenBlockStart, len(bc.Start), bc.End, "")
+	}
+	for _, prefix := range spec.RawStringPrefixes {
+		for _, str := range spec.Strings {
+			raw := prefix + str.Delim
+			consider(strings.Index(s, raw), tokenStringStart, len(raw), str.Delim, "")
+		}
+	}
+	for _, str := range spec.Strings {
+		consider(strings.Index(s, str.Delim), tokenStringStart, len(str.Delim), str.Delim, str.Escape)
+	}
+	if spec.Heredoc {
+		if loc := heredocRe.FindStringSubmatchIndex(s); loc != nil {
+			m := heredocRe.FindStringSubmatch(s)
+			delim := m[2]
+			if delim == "" {
+				delim = m[3]
+			}
+			if delim == "" {
+				delim = m[4]
+			}
+			strip := ""
+			if m[1] == "-" {
+				strip = "-"
+			}
+			consider(loc[0], tokenHeredocStart, loc[1]-loc[0], delim, strip)
+		}
+	}
+
+	if best == -1 {
+		return -1, tokenNone, 0, "", ""
+	}
+	return idx, kind, tokLen, payload, escape
+}
+
+// consumeString scans s, which starts right after an opening string
+// delimiter, for the matching close. It returns how many bytes were
+// consumed and whether the string was closed within s; if not, the whole
+// remainder of the line was consumed and the string stays open for the
+// next line (matches backtick/triple-quote raw strings).
+func consumeString(s, delim, escape string) (consumed int, closed bool) {
+	i := 0
+	for i < len(s) {
+		if escape != "" && strings.HasPrefix(s[i:], escape) {
+			i += len(escape)
+			if i < len(s) {
+				i++
+			}
+			continue
+		}
+		if strings.HasPrefix(s[i:], delim) {
+			return i + len(delim), true
+		}
+		i++
+	}
+	return len(s), false
+}
@@ -0,0 +1,32 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// BenchmarkAnalyze measures the single-pass scanner on a representative
+// Go source file, to catch regressions that would reintroduce reading
+// each file more than once.
+func BenchmarkAnalyze(b *testing.B) {
+	var sb strings.Builder
+	sb.WriteString("package main\n\n")
+	for i := 0; i < 500; i++ {
+		sb.WriteString("// comment line\n")
+		sb.WriteString("func f() { doSomething() } // trailing\n")
+		sb.WriteString("\n")
+	}
+	path := filepath.Join(b.TempDir(), "bench.go")
+	if err := os.WriteFile(path, []byte(sb.String()), 0o644); err != nil {
+		b.Fatalf("write fixture: %v", err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Analyze(path, ".go"); err != nil {
+			b.Fatalf("Analyze: %v", err)
+		}
+	}
+}
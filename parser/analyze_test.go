@@ -0,0 +1,121 @@
+package parser
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func analyzeSource(t *testing.T, ext, src string) Stats {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "fixture"+ext)
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("write fixture: %v", err)
+	}
+	stats, err := Analyze(path, ext)
+	if err != nil {
+		t.Fatalf("Analyze: %v", err)
+	}
+	return stats
+}
+
+func TestAnalyzeGo(t *testing.T) {
+	src := `package main
+
+// a pure comment
+func main() {
+	foo() // trailing comment
+	s := "// not a comment"
+	/* block
+	   comment */
+	bar()
+}
+`
+	stats := analyzeSource(t, ".go", src)
+	want := Stats{TotalLines: 10, CodeLines: 5, CommentLines: 3, MixedLines: 1, BlankLines: 1}
+	if stats != want {
+		t.Errorf("got %+v, want %+v", stats, want)
+	}
+}
+
+func TestAnalyzeGoStringContainingCommentToken(t *testing.T) {
+	stats := analyzeSource(t, ".go", `x := "/* not a comment */"`+"\n")
+	if stats.CommentLines != 0 || stats.CodeLines != 1 {
+		t.Errorf("string containing comment tokens misclassified: %+v", stats)
+	}
+}
+
+func TestAnalyzeGoCommentContainingStringToken(t *testing.T) {
+	stats := analyzeSource(t, ".go", `// this "looks like a string" but isn't`+"\n")
+	if stats.CommentLines != 1 || stats.CodeLines != 0 {
+		t.Errorf("comment containing string tokens misclassified: %+v", stats)
+	}
+}
+
+func TestAnalyzeRawStringSpansLines(t *testing.T) {
+	src := "x := `line one\n// still inside the raw string\nline three`\n"
+	stats := analyzeSource(t, ".go", src)
+	if stats.CommentLines != 0 {
+		t.Errorf("raw string line mistaken for comment: %+v", stats)
+	}
+	if stats.CodeLines != 3 {
+		t.Errorf("expected all 3 lines to count as code, got %+v", stats)
+	}
+}
+
+func TestAnalyzePythonTripleQuoteIsAString(t *testing.T) {
+	// Triple-quoted text is modeled as a string, not a block comment: a
+	// docstring and an ordinary multi-line string literal are the same
+	// construct to the line classifier, so both count as code.
+	src := "def f():\n    \"\"\"\n    multi-line docstring\n    \"\"\"\n    return 1\n"
+	stats := analyzeSource(t, ".py", src)
+	want := Stats{TotalLines: 5, CodeLines: 5, CommentLines: 0, MixedLines: 0, BlankLines: 0}
+	if stats != want {
+		t.Errorf("got %+v, want %+v", stats, want)
+	}
+}
+
+func TestAnalyzePythonMultiLineStringIsNotComment(t *testing.T) {
+	src := "sql = \"\"\"\nSELECT * FROM users\nWHERE id = 1\n\"\"\"\nprint(sql)\n"
+	stats := analyzeSource(t, ".py", src)
+	if stats.CommentLines != 0 {
+		t.Errorf("a multi-line string literal was misclassified as a comment: %+v", stats)
+	}
+	if stats.CodeLines != 5 {
+		t.Errorf("expected all 5 lines to count as code, got %+v", stats)
+	}
+}
+
+func TestAnalyzeShellHeredocBodyIsNotComment(t *testing.T) {
+	src := "cat <<EOF\n# not a comment, inside heredoc\nEOF\necho done\n"
+	stats := analyzeSource(t, ".sh", src)
+	if stats.CommentLines != 0 {
+		t.Errorf("a line inside a heredoc body was misclassified as a comment: %+v", stats)
+	}
+	if stats.CodeLines != 4 {
+		t.Errorf("expected all 4 lines to count as code, got %+v", stats)
+	}
+}
+
+func TestAnalyzeShellHeredocDashStripsLeadingTabs(t *testing.T) {
+	src := "cat <<-EOF\n\t\tindented body\n\tEOF\necho done\n"
+	stats := analyzeSource(t, ".sh", src)
+	if stats.CodeLines != 4 {
+		t.Errorf("expected <<- to match its closing delimiter after stripping leading tabs, got %+v", stats)
+	}
+}
+
+func TestAnalyzeShellCommentOutsideHeredocStillCounts(t *testing.T) {
+	src := "# a real comment\ncat <<EOF\nbody\nEOF\n"
+	stats := analyzeSource(t, ".sh", src)
+	if stats.CommentLines != 1 {
+		t.Errorf("expected the line before the heredoc to still count as a comment, got %+v", stats)
+	}
+}
+
+func TestAnalyzeUnknownExtensionTreatsEverythingAsCode(t *testing.T) {
+	stats := analyzeSource(t, ".xyz", "# might look like a comment\nplain line\n")
+	if stats.CodeLines != 2 || stats.CommentLines != 0 {
+		t.Errorf("unregistered extension should have no comment awareness: %+v", stats)
+	}
+}
@@ -0,0 +1,127 @@
+package parser
+
+import "regexp"
+
+// heredocRe matches a shell heredoc opener: "<<" or "<<-", an optional
+// quote around the delimiter (stripped, since quoting only disables
+// expansion inside the body and doesn't change the closing line), and the
+// delimiter word itself.
+var heredocRe = regexp.MustCompile(`<<(-?)\s*(?:"([A-Za-z_]\w*)"|'([A-Za-z_]\w*)'|([A-Za-z_]\w*))`)
+
+// BlockComment describes a pair of tokens that open and close a block
+// comment, e.g. "/*"/"*/" or "<!--"/"-->".
+type BlockComment struct {
+	Start string
+	End   string
+}
+
+// StringSpec describes a quoted-string form: the delimiter that opens and
+// closes it, and the escape token used to embed the delimiter inside the
+// string (empty if the language has no escaping for this delimiter).
+type StringSpec struct {
+	Delim  string
+	Escape string
+}
+
+// LangSpec is the per-extension token table the state machine in Analyze
+// consults to tell code, comments, and strings apart.
+type LangSpec struct {
+	LineComments  []string
+	BlockComments []BlockComment
+	Strings       []StringSpec
+	// RawStringPrefixes are prefixes (e.g. "r" in Rust, "@" in C#) that,
+	// immediately followed by one of Strings' delimiters, start a string
+	// with escaping disabled regardless of that StringSpec's Escape.
+	RawStringPrefixes []string
+	// Heredoc enables shell-style `<<[-]WORD` heredoc tracking: once seen,
+	// every line up to and including the one matching WORD is treated as
+	// string content and counted as code, so a "#" inside a heredoc body
+	// isn't misclassified as a comment.
+	Heredoc bool
+}
+
+// Languages maps file extensions (including the leading dot) to the token
+// table used to analyze files with that extension. Extensions without an
+// entry are counted as code-only (no comment or string awareness).
+var Languages = map[string]LangSpec{
+	".go": {
+		LineComments:      []string{"//"},
+		BlockComments:     []BlockComment{{"/*", "*/"}},
+		Strings:           []StringSpec{{`"`, `\`}, {"`", ""}, {"'", `\`}},
+		RawStringPrefixes: nil,
+	},
+	".rs": {
+		LineComments:      []string{"//"},
+		BlockComments:     []BlockComment{{"/*", "*/"}},
+		Strings:           []StringSpec{{`"`, `\`}, {"'", `\`}},
+		RawStringPrefixes: []string{"r"},
+	},
+	".js":  jsSpec,
+	".jsx": jsSpec,
+	".ts":  jsSpec,
+	".tsx": jsSpec,
+	".c":   cSpec,
+	".h":   cSpec,
+	".cpp": cSpec,
+	".hpp": cSpec,
+	".cs": {
+		LineComments:      []string{"//"},
+		BlockComments:     []BlockComment{{"/*", "*/"}},
+		Strings:           []StringSpec{{`"`, `\`}, {"'", `\`}},
+		RawStringPrefixes: []string{"@"},
+	},
+	".py": {
+		LineComments: []string{"#"},
+		// Triple-quoted text is modeled as a (multi-line) string rather
+		// than a block comment: unlike """docstrings""", a triple-quoted
+		// literal assigned to a variable is ordinary code, and nextToken's
+		// longest-match tie-break already prefers the triple-quote delim
+		// over the single-quote one when both start at the same position.
+		Strings: []StringSpec{{`"""`, `\`}, {"'''", `\`}, {`"`, `\`}, {"'", `\`}},
+	},
+	".rb": {
+		LineComments:  []string{"#"},
+		BlockComments: []BlockComment{{"=begin", "=end"}},
+		Strings:       []StringSpec{{`"`, `\`}, {"'", `\`}},
+	},
+	".html": {
+		BlockComments: []BlockComment{{"<!--", "-->"}},
+		Strings:       []StringSpec{{`"`, ""}, {"'", ""}},
+	},
+	".css": {
+		BlockComments: []BlockComment{{"/*", "*/"}},
+		Strings:       []StringSpec{{`"`, `\`}, {"'", `\`}},
+	},
+	".sh":   shellSpec,
+	".bash": shellSpec,
+	".zsh":  shellSpec,
+	".fish": shellSpec,
+	".yaml": shellSpec,
+	".yml":  shellSpec,
+	".toml": shellSpec,
+	".env":  shellSpec,
+	".ps1":  shellSpec,
+	".psm1": shellSpec,
+	".ini": {
+		LineComments: []string{";", "#"},
+		Strings:      []StringSpec{{`"`, `\`}, {"'", ""}},
+	},
+}
+
+var jsSpec = LangSpec{
+	LineComments:  []string{"//"},
+	BlockComments: []BlockComment{{"/*", "*/"}},
+	Strings:       []StringSpec{{`"`, `\`}, {"'", `\`}, {"`", `\`}},
+}
+
+var cSpec = LangSpec{
+	LineComments:  []string{"//"},
+	BlockComments: []BlockComment{{"/*", "*/"}},
+	Strings:       []StringSpec{{`"`, `\`}, {"'", `\`}},
+}
+
+var shellSpec = LangSpec{
+	LineComments: []string{"#"},
+	Strings:      []StringSpec{{`"`, `\`}, {"'", ""}},
+	Heredoc:      true,
+}